@@ -0,0 +1,51 @@
+package bip39
+
+import (
+	"io"
+)
+
+// EntropySource is any source of random (or pseudo-random) bytes that can be
+// plugged into the entropy-generating functions, e.g. to reproduce test vectors
+// with a deterministic PRNG or to draw from a hardware RNG instead of crypto/rand.
+// Every io.Reader already satisfies this interface.
+type EntropySource interface {
+	Read(p []byte) (n int, err error)
+}
+
+// Generate mnemonic from the specified words number, drawing the entropy from source
+// instead of crypto/rand.
+func MnemonicFromWordsNumWithRand(wordsNum int, source EntropySource) (*Mnemonic, error) {
+	return MnemonicFromWordsNumWithRandLang(wordsNum, source, LanguageEnglish)
+}
+
+// Generate mnemonic from the specified words number, using the words list of the
+// specified language and drawing the entropy from source instead of crypto/rand.
+func MnemonicFromWordsNumWithRandLang(wordsNum int, source EntropySource, lang Language) (*Mnemonic, error) {
+	err := validateWordsNum(wordsNum)
+	if err != nil {
+		return nil, err
+	}
+
+	entropyBitLen := (wordsNum * 11) - (wordsNum / 3)
+	entropy, err := GenerateEntropyWithRand(entropyBitLen, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return MnemonicFromEntropyLang(entropy, lang)
+}
+
+// Generate entropyBitLen bits of entropy, reading from source instead of crypto/rand.
+func GenerateEntropyWithRand(entropyBitLen int, source EntropySource) ([]byte, error) {
+	err := validateEntropyBitLen(entropyBitLen)
+	if err != nil {
+		return nil, err
+	}
+
+	entropy := make([]byte, entropyBitLen/8)
+	if _, err := io.ReadFull(source, entropy); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
@@ -0,0 +1,118 @@
+package bip39
+
+import (
+	"errors"
+)
+
+// Language identifies one of the word lists defined by the BIP-39 specification.
+type Language int
+
+const (
+	LanguageEnglish Language = iota
+	LanguageJapanese
+	LanguageKorean
+	LanguageSpanish
+	LanguageChineseSimplified
+	LanguageChineseTraditional
+	LanguageFrench
+	LanguageItalian
+)
+
+// wordSeparator returns the string used to join/split the mnemonic words of the specified language.
+// Japanese mnemonics are conventionally separated by the ideographic space "　" instead of a normal space.
+func (lang Language) wordSeparator() string {
+	if lang == LanguageJapanese {
+		return "　"
+	}
+	return " "
+}
+
+var (
+	ErrUnknownLanguage    = errors.New("The specified language is not supported")
+	ErrAmbiguousLanguage  = errors.New("The mnemonic words list matches more than one language")
+	ErrUndetectedLanguage = errors.New("The mnemonic language could not be detected")
+
+	wordsListByLanguage = map[Language][]string{
+		LanguageEnglish:            wordsListEn,
+		LanguageJapanese:           wordsListJa,
+		LanguageKorean:             wordsListKo,
+		LanguageSpanish:            wordsListEs,
+		LanguageChineseSimplified:  wordsListZhHans,
+		LanguageChineseTraditional: wordsListZhHant,
+		LanguageFrench:             wordsListFr,
+		LanguageItalian:            wordsListIt,
+	}
+
+	// Reverse lookup (word -> index) for every language, built once at init time.
+	// A map is used instead of a sorted-slice binary search because some of the
+	// lists above (Chinese, Japanese) are not kept in lexicographic order.
+	wordsIndexByLanguage = make(map[Language]map[string]int, len(wordsListByLanguage))
+)
+
+func init() {
+	for lang, words := range wordsListByLanguage {
+		index := make(map[string]int, len(words))
+		for i, word := range words {
+			index[word] = i
+		}
+		wordsIndexByLanguage[lang] = index
+	}
+}
+
+// Get the words list for the specified language.
+func wordsListForLanguage(lang Language) ([]string, error) {
+	words, ok := wordsListByLanguage[lang]
+	if !ok {
+		return nil, ErrUnknownLanguage
+	}
+	return words, nil
+}
+
+// Get the index of a word within the words list of the specified language.
+// The second return value is false if the word does not belong to the list.
+func wordIndex(lang Language, word string) (int, bool) {
+	idx, ok := wordsIndexByLanguage[lang][word]
+	return idx, ok
+}
+
+// WordsList returns the words list for the specified language, for packages that
+// need to reuse BIP-39's 11-bit-per-word encoding for data other than a Mnemonic
+// (e.g. the cipherseed subpackage).
+func WordsList(lang Language) ([]string, error) {
+	return wordsListForLanguage(lang)
+}
+
+// WordIndex returns the index of a word within the words list of the specified
+// language, for packages that need to reuse BIP-39's 11-bit-per-word encoding for
+// data other than a Mnemonic (e.g. the cipherseed subpackage).
+func WordIndex(lang Language, word string) (int, bool) {
+	return wordIndex(lang, word)
+}
+
+// Detect the language a mnemonic is written in, by checking which words list
+// every single word of it belongs to.
+// An error is returned if no language matches all the words, or if more than one does.
+func detectLanguage(words []string) (Language, error) {
+	detected := -1
+
+	for lang, index := range wordsIndexByLanguage {
+		allFound := true
+		for _, word := range words {
+			if _, ok := index[word]; !ok {
+				allFound = false
+				break
+			}
+		}
+		if allFound {
+			if detected != -1 {
+				return 0, ErrAmbiguousLanguage
+			}
+			detected = int(lang)
+		}
+	}
+
+	if detected == -1 {
+		return 0, ErrUndetectedLanguage
+	}
+	return Language(detected), nil
+}
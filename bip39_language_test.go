@@ -0,0 +1,97 @@
+package bip39
+
+import (
+	"testing"
+)
+
+func TestWordsListAndWordIndex(t *testing.T) {
+	words, err := WordsList(LanguageFrench)
+	if err != nil {
+		t.Fatalf("WordsList(LanguageFrench) returned error: %v", err)
+	}
+	if len(words) != 2048 {
+		t.Fatalf("WordsList(LanguageFrench) has %d words, want 2048", len(words))
+	}
+
+	idx, ok := WordIndex(LanguageFrench, words[42])
+	if !ok || idx != 42 {
+		t.Errorf("WordIndex(LanguageFrench, %q) = (%d, %v), want (42, true)", words[42], idx, ok)
+	}
+
+	if _, ok := WordIndex(LanguageFrench, "notaword"); ok {
+		t.Errorf("WordIndex(LanguageFrench, %q) = (_, true), want false", "notaword")
+	}
+
+	if _, err := WordsList(Language(99)); err != ErrUnknownLanguage {
+		t.Errorf("WordsList(Language(99)) = %v, want %v", err, ErrUnknownLanguage)
+	}
+}
+
+func TestDetectLanguageAmbiguous(t *testing.T) {
+	// "abandon" belongs to both the English and the French words list.
+	if _, err := detectLanguage([]string{"abandon"}); err != ErrAmbiguousLanguage {
+		t.Errorf("detectLanguage([abandon]) = %v, want %v", err, ErrAmbiguousLanguage)
+	}
+}
+
+func TestDetectLanguageUndetected(t *testing.T) {
+	if _, err := detectLanguage([]string{"notarealbip39word"}); err != ErrUndetectedLanguage {
+		t.Errorf("detectLanguage([notarealbip39word]) = %v, want %v", err, ErrUndetectedLanguage)
+	}
+}
+
+func TestSplitMnemonicWords(t *testing.T) {
+	got := splitMnemonicWords("abaisser abandon abdiquer")
+	want := []string{"abaisser", "abandon", "abdiquer"}
+	if len(got) != len(want) {
+		t.Fatalf("splitMnemonicWords = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitMnemonicWords[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	// Japanese mnemonics are separated by the ideographic space instead.
+	jaMnemonic, err := MnemonicFromWordsNumLang(WordsNum12, LanguageJapanese)
+	if err != nil {
+		t.Fatalf("MnemonicFromWordsNumLang(WordsNum12, LanguageJapanese) returned error: %v", err)
+	}
+	jaWords := splitMnemonicWords(jaMnemonic.Words)
+	if len(jaWords) != WordsNum12 {
+		t.Errorf("splitMnemonicWords(%q) has %d words, want %d", jaMnemonic.Words, len(jaWords), WordsNum12)
+	}
+}
+
+func TestMnemonicFromStringDetectsLanguage(t *testing.T) {
+	generated, err := MnemonicFromWordsNumLang(WordsNum12, LanguageFrench)
+	if err != nil {
+		t.Fatalf("MnemonicFromWordsNumLang(WordsNum12, LanguageFrench) returned error: %v", err)
+	}
+
+	mnemonic, err := MnemonicFromString(generated.Words)
+	if err != nil {
+		t.Fatalf("MnemonicFromString(%q) returned error: %v", generated.Words, err)
+	}
+	if mnemonic.Language != LanguageFrench {
+		t.Errorf("MnemonicFromString(%q).Language = %v, want %v", generated.Words, mnemonic.Language, LanguageFrench)
+	}
+
+	gotEntropy, err := mnemonic.ToEntropy()
+	if err != nil {
+		t.Fatalf("ToEntropy() returned error: %v", err)
+	}
+	wantEntropy, err := generated.ToEntropy()
+	if err != nil {
+		t.Fatalf("ToEntropy() on the original mnemonic returned error: %v", err)
+	}
+	if string(gotEntropy) != string(wantEntropy) {
+		t.Errorf("ToEntropy() = %x, want %x", gotEntropy, wantEntropy)
+	}
+}
+
+func TestMnemonicFromStringRejectsAmbiguousWords(t *testing.T) {
+	if _, err := MnemonicFromString("abandon"); err != ErrAmbiguousLanguage {
+		t.Errorf("MnemonicFromString(abandon) = %v, want %v", err, ErrAmbiguousLanguage)
+	}
+}
@@ -22,11 +22,13 @@ package bip39
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"crypto/sha512"
 	"errors"
 	"fmt"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
 	"strconv"
 	"strings"
 )
@@ -46,9 +48,10 @@ const (
 )
 
 var (
-	ErrWordsNum    = errors.New("The specified words number is not valid for mnemonic generation")
-	ErrInvalidWord = errors.New("The mnemonic contains an invalid word")
-	ErrChecksum    = errors.New("The checksum of the mnemonic is not valid")
+	ErrWordsNum      = errors.New("The specified words number is not valid for mnemonic generation")
+	ErrEntropyBitLen = errors.New("The specified entropy bit length is not valid for mnemonic generation")
+	ErrInvalidWord   = errors.New("The mnemonic contains an invalid word")
+	ErrChecksum      = errors.New("The checksum of the mnemonic is not valid")
 
 	wordsNumMap = map[int]bool{
 		WordsNum12: true,
@@ -57,16 +60,31 @@ var (
 		WordsNum21: true,
 		WordsNum24: true,
 	}
+
+	entropyBitLenMap = map[int]bool{
+		128: true,
+		160: true,
+		192: true,
+		224: true,
+		256: true,
+	}
 )
 
 // Structure for mnemonic
 type Mnemonic struct {
-	Words string
+	Words    string
+	Language Language
 }
 
 // Generate mnemonic from the specified words number.
 // A random entropy is used for generating mnemonic.
 func MnemonicFromWordsNum(wordsNum int) (*Mnemonic, error) {
+	return MnemonicFromWordsNumLang(wordsNum, LanguageEnglish)
+}
+
+// Generate mnemonic from the specified words number, using the words list of the specified language.
+// A random entropy is used for generating mnemonic.
+func MnemonicFromWordsNumLang(wordsNum int, lang Language) (*Mnemonic, error) {
 	err := validateWordsNum(wordsNum)
 	if err != nil {
 		return nil, err
@@ -75,17 +93,29 @@ func MnemonicFromWordsNum(wordsNum int) (*Mnemonic, error) {
 	entropyBitLen := (wordsNum * 11) - (wordsNum / 3)
 	entropy, _ := GenerateEntropy(entropyBitLen)
 
-	return MnemonicFromEntropy(entropy)
+	return MnemonicFromEntropyLang(entropy, lang)
 }
 
 // Generate mnemonic from the specific entropy.
 // The entropy slice shall be of a valid length.
+// The English words list is used.
 func MnemonicFromEntropy(entropy []byte) (*Mnemonic, error) {
+	return MnemonicFromEntropyLang(entropy, LanguageEnglish)
+}
+
+// Generate mnemonic from the specific entropy, using the words list of the specified language.
+// The entropy slice shall be of a valid length.
+func MnemonicFromEntropyLang(entropy []byte, lang Language) (*Mnemonic, error) {
 	err := validateEntropyBitLen(len(entropy) * 8)
 	if err != nil {
 		return nil, err
 	}
 
+	wordsList, err := wordsListForLanguage(lang)
+	if err != nil {
+		return nil, err
+	}
+
 	entropyBinStr := bytesToBinaryString(entropy)
 	chksumBinStr := entropyChecksumBinStr(entropy)
 	mnemonicBinStr := entropyBinStr + chksumBinStr
@@ -96,21 +126,46 @@ func MnemonicFromEntropy(entropy []byte) (*Mnemonic, error) {
 	for i := 0; i < mnemonicLen; i++ {
 		wordStrBin := mnemonicBinStr[i*wordBitLen : (i+1)*wordBitLen]
 		wordIdx, _ := strconv.ParseInt(wordStrBin, 2, 16)
-		mnemonic = append(mnemonic, wordsListEn[wordIdx])
+		mnemonic = append(mnemonic, wordsList[wordIdx])
 	}
 
 	return &Mnemonic{
-		Words: strings.Join(mnemonic, " "),
+		Words:    strings.Join(mnemonic, lang.wordSeparator()),
+		Language: lang,
 	}, nil
 }
 
-// Create mnemonic object from a mnemonic string.
-func MnemonicFromString(mnemonic string) *Mnemonic {
+// Create mnemonic object from a mnemonic string, auto-detecting its language.
+// An error is returned if the words do not all belong to the same, single words list.
+func MnemonicFromString(mnemonic string) (*Mnemonic, error) {
+	lang, err := detectLanguage(splitMnemonicWords(mnemonic))
+	if err != nil {
+		return nil, err
+	}
+	return MnemonicFromStringLang(mnemonic, lang), nil
+}
+
+// Create mnemonic object from a mnemonic string, using the words list of the specified language.
+func MnemonicFromStringLang(mnemonic string, lang Language) *Mnemonic {
 	return &Mnemonic{
-		Words: mnemonic,
+		Words:    mnemonic,
+		Language: lang,
 	}
 }
 
+// Split a mnemonic string into its words, without knowing its language yet.
+// Since only Japanese uses a different separator, a mnemonic is first split on normal spaces;
+// if that yields a single "word", it is retried with the Japanese ideographic space.
+func splitMnemonicWords(mnemonic string) []string {
+	words := strings.Split(mnemonic, " ")
+	if len(words) == 1 {
+		if jaWords := strings.Split(mnemonic, LanguageJapanese.wordSeparator()); len(jaWords) > 1 {
+			return jaWords
+		}
+	}
+	return words
+}
+
 // Convert a mnemonic back to entropy bytes.
 // Error is returned if mnemonic or checksum is not valid.
 func (mnemonic *Mnemonic) ToEntropy() ([]byte, error) {
@@ -154,14 +209,27 @@ func (mnemonic *Mnemonic) IsValid() bool {
 }
 
 // Generate the seed from a mnemonic using the specified passphrase for protection.
+// Per the BIP-39 specification, both the mnemonic and the passphrase are NFKD-normalized
+// before being fed to PBKDF2; this matters in particular for Japanese mnemonics.
 func (mnemonic *Mnemonic) GenerateSeed(passphrase string) ([]byte, error) {
 	err := mnemonic.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	salt := seedSaltMod + passphrase
-	return pbkdf2.Key([]byte(mnemonic.Words), []byte(salt), seedPbkdf2Round, seedPbkdf2KeyLen, sha512.New), nil
+	normWords := norm.NFKD.String(mnemonic.Words)
+	salt := seedSaltMod + norm.NFKD.String(passphrase)
+	return pbkdf2.Key([]byte(normWords), []byte(salt), seedPbkdf2Round, seedPbkdf2KeyLen, sha512.New), nil
+}
+
+// NewSeed derives the seed straight from a mnemonic and passphrase string, without
+// going through Mnemonic/Validate first. This mirrors the widely-used tyler-smith
+// API and is handy for reproducing official BIP-39 test vectors, which pair a
+// mnemonic with its expected seed regardless of whether the mnemonic is valid.
+func NewSeed(mnemonic, passphrase string) []byte {
+	normWords := norm.NFKD.String(mnemonic)
+	salt := seedSaltMod + norm.NFKD.String(passphrase)
+	return pbkdf2.Key([]byte(normWords), []byte(salt), seedPbkdf2Round, seedPbkdf2KeyLen, sha512.New)
 }
 
 // Validate the specified words number.
@@ -172,6 +240,29 @@ func validateWordsNum(wordsNum int) error {
 	return nil
 }
 
+// Validate the specified entropy bit length.
+func validateEntropyBitLen(entropyBitLen int) error {
+	if !entropyBitLenMap[entropyBitLen] {
+		return ErrEntropyBitLen
+	}
+	return nil
+}
+
+// Generate entropyBitLen bits of entropy using crypto/rand.
+func GenerateEntropy(entropyBitLen int) ([]byte, error) {
+	err := validateEntropyBitLen(entropyBitLen)
+	if err != nil {
+		return nil, err
+	}
+
+	entropy := make([]byte, entropyBitLen/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
+
 // Compute checksum of the specified entropy bytes, returned as a binary string.
 func entropyChecksumBinStr(slice []byte) string {
 	hash := sha256.Sum256(slice)
@@ -183,16 +274,16 @@ func entropyChecksumBinStr(slice []byte) string {
 // Get the binary strings back from a mnemonic.
 // The function returns both entropy and checksum parts.
 func (mnemonic *Mnemonic) getBinaryStrings() (string, string, error) {
-	wordsList := strings.Split(mnemonic.Words, " ")
-	err := validateWordsNum(len(wordsList))
+	words := strings.Split(mnemonic.Words, mnemonic.Language.wordSeparator())
+	err := validateWordsNum(len(words))
 	if err != nil {
 		return "", "", err
 	}
 
 	var strBuf bytes.Buffer
-	for _, word := range wordsList {
-		wordIdx := stringBinarySearch(wordsListEn, word)
-		if wordIdx == -1 {
+	for _, word := range words {
+		wordIdx, ok := wordIndex(mnemonic.Language, word)
+		if !ok {
 			return "", "", ErrInvalidWord
 		}
 		strBuf.WriteString(fmt.Sprintf("%.11b", wordIdx))
@@ -0,0 +1,73 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestMnemonicValidateAndIsValid(t *testing.T) {
+	mnemonic := MnemonicFromStringLang(
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		LanguageEnglish,
+	)
+	if err := mnemonic.Validate(); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+	if !mnemonic.IsValid() {
+		t.Errorf("IsValid() = false, want true")
+	}
+}
+
+func TestMnemonicValidateRejectsBadChecksum(t *testing.T) {
+	// Swapping the last word of a valid mnemonic keeps every word in the
+	// English words list, but breaks the checksum.
+	mnemonic := MnemonicFromStringLang(
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon ability",
+		LanguageEnglish,
+	)
+	if err := mnemonic.Validate(); err != ErrChecksum {
+		t.Errorf("Validate() = %v, want %v", err, ErrChecksum)
+	}
+	if mnemonic.IsValid() {
+		t.Errorf("IsValid() = true, want false")
+	}
+}
+
+func TestMnemonicValidateRejectsUnknownWord(t *testing.T) {
+	mnemonic := MnemonicFromStringLang(
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon notaword",
+		LanguageEnglish,
+	)
+	if err := mnemonic.Validate(); err != ErrInvalidWord {
+		t.Errorf("Validate() = %v, want %v", err, ErrInvalidWord)
+	}
+}
+
+func TestMnemonicValidateRejectsWrongWordsNum(t *testing.T) {
+	mnemonic := MnemonicFromStringLang("abandon abandon abandon", LanguageEnglish)
+	if err := mnemonic.Validate(); err != ErrWordsNum {
+		t.Errorf("Validate() = %v, want %v", err, ErrWordsNum)
+	}
+}
+
+func TestGenerateSeedMatchesNewSeed(t *testing.T) {
+	words := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	mnemonic := MnemonicFromStringLang(words, LanguageEnglish)
+
+	got, err := mnemonic.GenerateSeed("TREZOR")
+	if err != nil {
+		t.Fatalf("GenerateSeed returned error: %v", err)
+	}
+
+	want := NewSeed(words, "TREZOR")
+	if hex.EncodeToString(got) != hex.EncodeToString(want) {
+		t.Errorf("GenerateSeed = %x, want %x", got, want)
+	}
+}
+
+func TestGenerateSeedRejectsInvalidMnemonic(t *testing.T) {
+	mnemonic := MnemonicFromStringLang("notaword notaword notaword", LanguageEnglish)
+	if _, err := mnemonic.GenerateSeed("TREZOR"); err == nil {
+		t.Errorf("GenerateSeed on an invalid mnemonic returned no error")
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"sort"
 	"strconv"
 )
 
@@ -61,16 +60,3 @@ func binaryStringToBytes(binStr string) ([]byte, error) {
 
 	return slice, nil
 }
-
-// Perform binary search to find a string in a slice, by returning its index.
-// If not found, -1 will be returned.
-// The algorithm is simply implemented by using the sort library.
-func stringBinarySearch(slice []string, elem string) int {
-	idx := sort.SearchStrings(slice, elem)
-
-	if idx != len(slice) && slice[idx] == elem {
-		return idx
-	} else {
-		return -1
-	}
-}
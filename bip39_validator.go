@@ -0,0 +1,80 @@
+package bip39
+
+import (
+	"crypto/sha256"
+)
+
+// maxEncodedBytes is the number of bytes needed to hold the packed bits of the
+// longest supported mnemonic (24 words * 11 bits = 264 bits = 33 bytes).
+const maxEncodedBytes = (WordsNum24*wordBitLen + 7) / 8
+
+// Validator is a reusable, allocation-light checker for candidate English
+// mnemonics, intended for tools that need to check millions of candidates per
+// second (e.g. partial-seed recovery). It precomputes the words list reverse
+// lookup once, and its IsValidWords method avoids strings.Split, fmt.Sprintf and
+// repeated bytes.Buffer allocations by bit-packing words directly into a fixed
+// size buffer.
+type Validator struct {
+	wordsIndex map[string]int
+}
+
+// Create a new Validator for the English words list.
+func NewValidator() *Validator {
+	return &Validator{
+		wordsIndex: wordsIndexByLanguage[LanguageEnglish],
+	}
+}
+
+// Check whether words is a valid English mnemonic, i.e. every word belongs to
+// the words list and the checksum is valid. Unlike Mnemonic.Validate, words is
+// already split, so no separator handling is needed.
+func (v *Validator) IsValidWords(words []string) bool {
+	if err := validateWordsNum(len(words)); err != nil {
+		return false
+	}
+
+	var packed [maxEncodedBytes]byte
+	bitLen := 0
+	for _, word := range words {
+		wordIdx, ok := v.wordsIndex[word]
+		if !ok {
+			return false
+		}
+		writeBits(&packed, bitLen, wordBitLen, uint16(wordIdx))
+		bitLen += wordBitLen
+	}
+
+	chksumBitLen := bitLen / 33
+	entropyBitLen := bitLen - chksumBitLen
+	entropyByteLen := entropyBitLen / 8
+
+	hash := sha256.Sum256(packed[:entropyByteLen])
+	wantChksum := readBits(&packed, entropyBitLen, chksumBitLen)
+	gotChksum := hash[0] >> (8 - chksumBitLen)
+
+	return gotChksum == wantChksum
+}
+
+// writeBits writes the bitLen least-significant bits of value into buf, starting
+// at bit offset bitOffset (bit 0 being the most significant bit of buf[0]).
+func writeBits(buf *[maxEncodedBytes]byte, bitOffset, bitLen int, value uint16) {
+	for i := 0; i < bitLen; i++ {
+		bit := (value >> (bitLen - 1 - i)) & 1
+		pos := bitOffset + i
+		if bit != 0 {
+			buf[pos/8] |= 1 << (7 - uint(pos%8))
+		}
+	}
+}
+
+// readBits reads bitLen bits from buf starting at bit offset bitOffset and
+// returns them right-aligned in a byte. bitLen must not exceed 8.
+func readBits(buf *[maxEncodedBytes]byte, bitOffset, bitLen int) byte {
+	var value byte
+	for i := 0; i < bitLen; i++ {
+		pos := bitOffset + i
+		bit := (buf[pos/8] >> (7 - uint(pos%8))) & 1
+		value = (value << 1) | bit
+	}
+	return value
+}
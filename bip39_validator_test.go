@@ -0,0 +1,58 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatorIsValidWords(t *testing.T) {
+	v := NewValidator()
+
+	words := strings.Split("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", " ")
+	if !v.IsValidWords(words) {
+		t.Errorf("IsValidWords(%v) = false, want true", words)
+	}
+}
+
+func TestValidatorIsValidWordsAgreesWithValidate(t *testing.T) {
+	v := NewValidator()
+
+	mnemonic, err := MnemonicFromWordsNum(WordsNum24)
+	if err != nil {
+		t.Fatalf("MnemonicFromWordsNum returned error: %v", err)
+	}
+	words := strings.Split(mnemonic.Words, " ")
+
+	if !v.IsValidWords(words) {
+		t.Errorf("IsValidWords(%v) = false, want true for a freshly generated mnemonic", words)
+	}
+}
+
+func TestValidatorIsValidWordsRejectsBadChecksum(t *testing.T) {
+	v := NewValidator()
+
+	// Swapping the last word keeps every word in the words list, but breaks
+	// the checksum.
+	words := strings.Split("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon ability", " ")
+	if v.IsValidWords(words) {
+		t.Errorf("IsValidWords(%v) = true, want false", words)
+	}
+}
+
+func TestValidatorIsValidWordsRejectsUnknownWord(t *testing.T) {
+	v := NewValidator()
+
+	words := strings.Split("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon notaword", " ")
+	if v.IsValidWords(words) {
+		t.Errorf("IsValidWords(%v) = true, want false", words)
+	}
+}
+
+func TestValidatorIsValidWordsRejectsWrongWordsNum(t *testing.T) {
+	v := NewValidator()
+
+	words := strings.Split("abandon abandon abandon", " ")
+	if v.IsValidWords(words) {
+		t.Errorf("IsValidWords(%v) = true, want false", words)
+	}
+}
@@ -0,0 +1,123 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/codesoap/go-bip39/hdkey"
+)
+
+// testVector mirrors one entry of the Trezor BIP-39 vectors.json format:
+// https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+type testVector struct {
+	Entropy    string `json:"entropy"`
+	Mnemonic   string `json:"mnemonic"`
+	Passphrase string `json:"passphrase"`
+	Seed       string `json:"seed"`
+	Xprv       string `json:"xprv"`
+}
+
+func loadTestVectors(t *testing.T, path string) []testVector {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read test vectors file %q: %v", path, err)
+	}
+
+	var vectors []testVector
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatalf("unable to parse test vectors file %q: %v", path, err)
+	}
+
+	return vectors
+}
+
+// TestOfficialVectors certifies MnemonicFromEntropy, ToEntropy, NewSeed and the
+// hdkey BIP-32 master key derived from the seed against the official Trezor
+// BIP-39 test vectors for English, across every supported entropy length.
+func TestOfficialVectors(t *testing.T) {
+	for _, vector := range loadTestVectors(t, "testdata/vectors_en.json") {
+		entropy, err := hex.DecodeString(vector.Entropy)
+		if err != nil {
+			t.Fatalf("invalid entropy hex %q: %v", vector.Entropy, err)
+		}
+
+		mnemonic, err := MnemonicFromEntropy(entropy)
+		if err != nil {
+			t.Errorf("MnemonicFromEntropy(%x) returned error: %v", entropy, err)
+			continue
+		}
+		if mnemonic.Words != vector.Mnemonic {
+			t.Errorf("MnemonicFromEntropy(%x).Words = %q, want %q", entropy, mnemonic.Words, vector.Mnemonic)
+		}
+
+		gotEntropy, err := mnemonic.ToEntropy()
+		if err != nil {
+			t.Errorf("%q.ToEntropy() returned error: %v", vector.Mnemonic, err)
+		} else if hex.EncodeToString(gotEntropy) != vector.Entropy {
+			t.Errorf("%q.ToEntropy() = %x, want %s", vector.Mnemonic, gotEntropy, vector.Entropy)
+		}
+
+		gotSeed := NewSeed(vector.Mnemonic, vector.Passphrase)
+		if hex.EncodeToString(gotSeed) != vector.Seed {
+			t.Errorf("NewSeed(%q, %q) = %x, want %s", vector.Mnemonic, vector.Passphrase, gotSeed, vector.Seed)
+		}
+
+		masterKey, err := hdkey.NewMasterKey(gotSeed)
+		if err != nil {
+			t.Errorf("hdkey.NewMasterKey(%x) returned error: %v", gotSeed, err)
+		} else if masterKey.String() != vector.Xprv {
+			t.Errorf("hdkey.NewMasterKey(%x).String() = %s, want %s", gotSeed, masterKey.String(), vector.Xprv)
+		}
+	}
+}
+
+// TestOfficialVectorsJapanese is the Japanese counterpart of TestOfficialVectors.
+// Japanese mnemonics are joined with the ideographic space instead of a normal
+// one, and the passphrase below is the classic NFKD torture test: it mixes
+// compatibility characters (e.g. "㍍") that only normalize to their canonical
+// form once NFKD-decomposed, which is exactly the step GenerateSeed must apply
+// before PBKDF2 for the seed to come out right.
+func TestOfficialVectorsJapanese(t *testing.T) {
+	for _, vector := range loadTestVectors(t, "testdata/vectors_ja.json") {
+		entropy, err := hex.DecodeString(vector.Entropy)
+		if err != nil {
+			t.Fatalf("invalid entropy hex %q: %v", vector.Entropy, err)
+		}
+
+		mnemonic, err := MnemonicFromEntropyLang(entropy, LanguageJapanese)
+		if err != nil {
+			t.Errorf("MnemonicFromEntropyLang(%x, LanguageJapanese) returned error: %v", entropy, err)
+			continue
+		}
+		if mnemonic.Words != vector.Mnemonic {
+			t.Errorf("MnemonicFromEntropyLang(%x, LanguageJapanese).Words = %q, want %q", entropy, mnemonic.Words, vector.Mnemonic)
+		}
+
+		gotEntropy, err := mnemonic.ToEntropy()
+		if err != nil {
+			t.Errorf("%q.ToEntropy() returned error: %v", vector.Mnemonic, err)
+		} else if hex.EncodeToString(gotEntropy) != vector.Entropy {
+			t.Errorf("%q.ToEntropy() = %x, want %s", vector.Mnemonic, gotEntropy, vector.Entropy)
+		}
+
+		gotSeed, err := mnemonic.GenerateSeed(vector.Passphrase)
+		if err != nil {
+			t.Errorf("%q.GenerateSeed(%q) returned error: %v", vector.Mnemonic, vector.Passphrase, err)
+			continue
+		}
+		if hex.EncodeToString(gotSeed) != vector.Seed {
+			t.Errorf("%q.GenerateSeed(%q) = %x, want %s", vector.Mnemonic, vector.Passphrase, gotSeed, vector.Seed)
+		}
+
+		masterKey, err := hdkey.NewMasterKey(gotSeed)
+		if err != nil {
+			t.Errorf("hdkey.NewMasterKey(%x) returned error: %v", gotSeed, err)
+		} else if masterKey.String() != vector.Xprv {
+			t.Errorf("hdkey.NewMasterKey(%x).String() = %s, want %s", gotSeed, masterKey.String(), vector.Xprv)
+		}
+	}
+}
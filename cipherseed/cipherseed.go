@@ -0,0 +1,318 @@
+// Package cipherseed implements an aezeed-style encrypted cipher seed: a 24-word
+// mnemonic that packs a version, a wallet birthday and 16 bytes of entropy,
+// encrypted under a mandatory passphrase. The only checksum carried by the
+// mnemonic covers the ciphertext and protects against transcription errors, not
+// against passphrase guesses. A wrong passphrase deciphers to junk: in most cases
+// its version byte does not match Version and decoding fails with
+// ErrUnknownVersion; otherwise it yields a different CipherSeed with no error.
+package cipherseed
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codesoap/go-bip39"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Version is the only cipher seed format this package knows how to produce.
+	Version uint8 = 0
+
+	entropySize    = 16
+	birthdaySize   = 2
+	reservedSize   = 5
+	packedSize     = 1 + birthdaySize + entropySize + reservedSize // version || birthday || entropy || reserved
+	saltSize       = 5
+	checksumSize   = 4
+	encipheredSize = saltSize + packedSize + checksumSize // salt || ciphertext || checksum
+
+	wordBitLen   = 11
+	numWords     = 24
+	scryptKeyLen = 32 // AES-256 key
+	scryptIVLen  = aes.BlockSize
+)
+
+// scrypt cost parameters. N is deliberately high, since key derivation is meant
+// to be the expensive step a brute-force attacker has to repeat for every guess.
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// bitcoinGenesisEpoch is the reference date a CipherSeed's birthday is counted
+// from, expressed as the number of days elapsed since this instant.
+var bitcoinGenesisEpoch = time.Date(2009, time.January, 3, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrInvalidPassphrase = errors.New("cipherseed: passphrase must not be empty")
+	ErrInvalidWordsNum   = errors.New("cipherseed: a cipher seed mnemonic must have exactly 24 words")
+	ErrInvalidWord       = errors.New("cipherseed: the mnemonic contains a word that is not in the English words list")
+	ErrChecksum          = errors.New("cipherseed: the mnemonic checksum is not valid")
+	ErrUnknownVersion    = errors.New("cipherseed: the deciphered seed has an unsupported version")
+	ErrInvalidBirthday   = errors.New("cipherseed: birthday must not be before the Bitcoin genesis epoch or too far in the future to encode")
+)
+
+// maxBirthdayDays is the largest number of days since bitcoinGenesisEpoch that
+// fits in the mnemonic's 2-byte birthday field.
+const maxBirthdayDays = 1<<16 - 1
+
+// CipherSeed is the deciphered content of an aezeed-style mnemonic.
+type CipherSeed struct {
+	Entropy  [entropySize]byte
+	Birthday time.Time
+	salt     [saltSize]byte
+}
+
+// New creates a CipherSeed for the given entropy and birthday, ready to be
+// encrypted under passphrase when its Mnemonic is requested.
+func New(entropy [entropySize]byte, passphrase []byte, birthday time.Time) (*CipherSeed, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrInvalidPassphrase
+	}
+	if _, err := birthdayDays(birthday); err != nil {
+		return nil, err
+	}
+
+	var salt [saltSize]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return nil, fmt.Errorf("cipherseed: unable to generate salt: %w", err)
+	}
+
+	return &CipherSeed{
+		Entropy:  entropy,
+		Birthday: birthday,
+		salt:     salt,
+	}, nil
+}
+
+// Mnemonic enciphers the seed under passphrase and encodes it as 24 English
+// BIP-39 words.
+func (seed *CipherSeed) Mnemonic(passphrase []byte) ([numWords]string, error) {
+	var mnemonic [numWords]string
+
+	if len(passphrase) == 0 {
+		return mnemonic, ErrInvalidPassphrase
+	}
+
+	packed, err := seed.pack()
+	if err != nil {
+		return mnemonic, err
+	}
+
+	key, iv, err := deriveKeyIV(passphrase, seed.salt[:])
+	if err != nil {
+		return mnemonic, err
+	}
+
+	ciphertext, err := cryptPacked(key, iv, packed[:])
+	if err != nil {
+		return mnemonic, err
+	}
+
+	var enciphered [encipheredSize]byte
+	copy(enciphered[:saltSize], seed.salt[:])
+	copy(enciphered[saltSize:saltSize+packedSize], ciphertext)
+	binary4 := crc32.ChecksumIEEE(ciphertext)
+	enciphered[saltSize+packedSize] = byte(binary4 >> 24)
+	enciphered[saltSize+packedSize+1] = byte(binary4 >> 16)
+	enciphered[saltSize+packedSize+2] = byte(binary4 >> 8)
+	enciphered[saltSize+packedSize+3] = byte(binary4)
+
+	words, err := bytesToWords(enciphered[:])
+	if err != nil {
+		return mnemonic, err
+	}
+	copy(mnemonic[:], words)
+
+	return mnemonic, nil
+}
+
+// MnemonicToCipherSeed deciphers a 24-word mnemonic back into a CipherSeed using
+// passphrase. The transcription checksum is checked before deciphering, and the
+// version byte after it. A wrong passphrase is not reported as such: it
+// deciphers to unrelated junk, which in most cases fails the version check with
+// ErrUnknownVersion and otherwise is returned as a CipherSeed with no error.
+func MnemonicToCipherSeed(words [numWords]string, passphrase []byte) (*CipherSeed, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrInvalidPassphrase
+	}
+
+	enciphered, err := wordsToBytes(words)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := enciphered[:saltSize]
+	ciphertext := enciphered[saltSize : saltSize+packedSize]
+	chksum := enciphered[saltSize+packedSize:]
+
+	gotChksum := crc32.ChecksumIEEE(ciphertext)
+	wantChksum := uint32(chksum[0])<<24 | uint32(chksum[1])<<16 | uint32(chksum[2])<<8 | uint32(chksum[3])
+	if gotChksum != wantChksum {
+		return nil, ErrChecksum
+	}
+
+	key, iv, err := deriveKeyIV(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := cryptPacked(key, iv, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := &CipherSeed{}
+	copy(seed.salt[:], salt)
+	if err := seed.unpack(packed); err != nil {
+		return nil, err
+	}
+
+	return seed, nil
+}
+
+// ChangePassphrase deciphers a mnemonic with oldPassphrase and re-enciphers the
+// resulting seed under newPassphrase, rolling a fresh salt in the process.
+func ChangePassphrase(words [numWords]string, oldPassphrase, newPassphrase []byte) ([numWords]string, error) {
+	var mnemonic [numWords]string
+
+	seed, err := MnemonicToCipherSeed(words, oldPassphrase)
+	if err != nil {
+		return mnemonic, err
+	}
+
+	reseeded, err := New(seed.Entropy, newPassphrase, seed.Birthday)
+	if err != nil {
+		return mnemonic, err
+	}
+
+	return reseeded.Mnemonic(newPassphrase)
+}
+
+// birthdayDays validates that birthday falls within the range encodable in the
+// mnemonic's 2-byte birthday field and returns it as days since
+// bitcoinGenesisEpoch.
+func birthdayDays(birthday time.Time) (uint16, error) {
+	hours := birthday.UTC().Sub(bitcoinGenesisEpoch).Hours()
+	if hours < 0 || hours/24 > maxBirthdayDays {
+		return 0, ErrInvalidBirthday
+	}
+	return uint16(hours / 24), nil
+}
+
+// pack lays the seed out as version || birthday || entropy || reserved. The
+// birthday is assumed to already be valid, since it is checked by New.
+func (seed *CipherSeed) pack() ([packedSize]byte, error) {
+	var packed [packedSize]byte
+
+	packed[0] = Version
+
+	days, err := birthdayDays(seed.Birthday)
+	if err != nil {
+		return packed, err
+	}
+	packed[1] = byte(days >> 8)
+	packed[2] = byte(days)
+
+	copy(packed[1+birthdaySize:1+birthdaySize+entropySize], seed.Entropy[:])
+
+	// The remaining reservedSize bytes are left zeroed, for future extensions.
+	return packed, nil
+}
+
+// unpack is the inverse of pack.
+func (seed *CipherSeed) unpack(packed []byte) error {
+	if len(packed) != packedSize {
+		return ErrInvalidWordsNum
+	}
+	if packed[0] != Version {
+		return ErrUnknownVersion
+	}
+
+	days := uint16(packed[1])<<8 | uint16(packed[2])
+	seed.Birthday = bitcoinGenesisEpoch.Add(time.Duration(days) * 24 * time.Hour)
+
+	copy(seed.Entropy[:], packed[1+birthdaySize:1+birthdaySize+entropySize])
+
+	return nil
+}
+
+// deriveKeyIV derives an AES-256 key and a CTR initialization vector from
+// passphrase and salt using scrypt.
+func deriveKeyIV(passphrase, salt []byte) (key, iv []byte, err error) {
+	material, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen+scryptIVLen)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cipherseed: scrypt key derivation failed: %w", err)
+	}
+	return material[:scryptKeyLen], material[scryptKeyLen:], nil
+}
+
+// cryptPacked runs AES-256-CTR over data; being a stream cipher, the same
+// function enciphers and deciphers and never changes the data's length.
+func cryptPacked(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("cipherseed: unable to create AES cipher: %w", err)
+	}
+
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
+
+// bytesToWords maps encipheredSize bytes to numWords English BIP-39 words,
+// reusing the same 11-bit-per-word scheme as a plain Mnemonic.
+func bytesToWords(data []byte) ([]string, error) {
+	wordsList, err := bip39.WordsList(bip39.LanguageEnglish)
+	if err != nil {
+		return nil, err
+	}
+
+	var bits strings.Builder
+	for _, b := range data {
+		bits.WriteString(fmt.Sprintf("%.8b", b))
+	}
+	binStr := bits.String()
+
+	words := make([]string, 0, numWords)
+	for i := 0; i < numWords; i++ {
+		wordIdx, err := strconv.ParseInt(binStr[i*wordBitLen:(i+1)*wordBitLen], 2, 16)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, wordsList[wordIdx])
+	}
+	return words, nil
+}
+
+// wordsToBytes is the inverse of bytesToWords.
+func wordsToBytes(words [numWords]string) ([]byte, error) {
+	var bits strings.Builder
+	for _, word := range words {
+		wordIdx, ok := bip39.WordIndex(bip39.LanguageEnglish, word)
+		if !ok {
+			return nil, ErrInvalidWord
+		}
+		bits.WriteString(fmt.Sprintf("%.11b", wordIdx))
+	}
+	binStr := bits.String()
+
+	out := make([]byte, len(binStr)/8)
+	for i := range out {
+		byteVal, err := strconv.ParseInt(binStr[i*8:(i+1)*8], 2, 16)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = byte(byteVal)
+	}
+	return out, nil
+}
@@ -0,0 +1,166 @@
+package cipherseed
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/codesoap/go-bip39"
+)
+
+func TestRoundTrip(t *testing.T) {
+	entropy := [entropySize]byte{0: 0x01, 15: 0xff}
+	birthday := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	passphrase := []byte("correct horse battery staple")
+
+	seed, err := New(entropy, passphrase, birthday)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	mnemonic, err := seed.Mnemonic(passphrase)
+	if err != nil {
+		t.Fatalf("Mnemonic returned error: %v", err)
+	}
+
+	got, err := MnemonicToCipherSeed(mnemonic, passphrase)
+	if err != nil {
+		t.Fatalf("MnemonicToCipherSeed returned error: %v", err)
+	}
+
+	if got.Entropy != entropy {
+		t.Errorf("Entropy = %x, want %x", got.Entropy, entropy)
+	}
+	if !got.Birthday.Equal(birthday) {
+		t.Errorf("Birthday = %v, want %v", got.Birthday, birthday)
+	}
+}
+
+func TestMnemonicToCipherSeedWrongPassphrase(t *testing.T) {
+	entropy := [entropySize]byte{0: 0x01, 15: 0xff}
+	birthday := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	passphrase := []byte("right passphrase")
+
+	seed, err := New(entropy, passphrase, birthday)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	mnemonic, err := seed.Mnemonic(passphrase)
+	if err != nil {
+		t.Fatalf("Mnemonic returned error: %v", err)
+	}
+
+	// A wrong passphrase still passes the transcription checksum (it is
+	// computed over the ciphertext, not the plaintext): it never gets a
+	// dedicated error, it just deciphers to unrelated junk, which is
+	// usually (but, since the version byte only has 256 possible values,
+	// not always) caught by the version check instead.
+	wrong, err := MnemonicToCipherSeed(mnemonic, []byte("wrong passphrase"))
+	if err == nil && wrong.Entropy == entropy {
+		t.Errorf("wrong passphrase decrypted to the original entropy")
+	}
+	if err != nil && err != ErrUnknownVersion {
+		t.Errorf("MnemonicToCipherSeed with wrong passphrase returned unexpected error: %v", err)
+	}
+}
+
+func TestMnemonicToCipherSeedBadChecksum(t *testing.T) {
+	entropy := [entropySize]byte{0: 0x01}
+	passphrase := []byte("a passphrase")
+
+	seed, err := New(entropy, passphrase, time.Now().UTC().Truncate(24*time.Hour))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	mnemonic, err := seed.Mnemonic(passphrase)
+	if err != nil {
+		t.Fatalf("Mnemonic returned error: %v", err)
+	}
+
+	// The checksum only covers the ciphertext portion of the mnemonic
+	// (the first few words encode the unchecked salt instead), so the
+	// corrupted word must be chosen from past the salt.
+	const corruptWord = 10
+	wordsList, err := bip39.WordsList(bip39.LanguageEnglish)
+	if err != nil {
+		t.Fatalf("WordsList returned error: %v", err)
+	}
+	corruptIdx, _ := bip39.WordIndex(bip39.LanguageEnglish, mnemonic[corruptWord])
+	mnemonic[corruptWord] = wordsList[(corruptIdx+1)%len(wordsList)]
+
+	if _, err := MnemonicToCipherSeed(mnemonic, passphrase); err != ErrChecksum {
+		t.Errorf("MnemonicToCipherSeed with a corrupted word = %v, want %v", err, ErrChecksum)
+	}
+}
+
+func TestChangePassphrase(t *testing.T) {
+	entropy := [entropySize]byte{0: 0x42}
+	birthday := time.Date(2019, time.March, 3, 0, 0, 0, 0, time.UTC)
+	oldPassphrase := []byte("old passphrase")
+	newPassphrase := []byte("new passphrase")
+
+	seed, err := New(entropy, oldPassphrase, birthday)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	mnemonic, err := seed.Mnemonic(oldPassphrase)
+	if err != nil {
+		t.Fatalf("Mnemonic returned error: %v", err)
+	}
+
+	newMnemonic, err := ChangePassphrase(mnemonic, oldPassphrase, newPassphrase)
+	if err != nil {
+		t.Fatalf("ChangePassphrase returned error: %v", err)
+	}
+	if bytes.Equal([]byte(mnemonic[0]), []byte(newMnemonic[0])) && mnemonic == newMnemonic {
+		t.Errorf("ChangePassphrase did not roll the mnemonic")
+	}
+
+	got, err := MnemonicToCipherSeed(newMnemonic, newPassphrase)
+	if err != nil {
+		t.Fatalf("MnemonicToCipherSeed with new passphrase returned error: %v", err)
+	}
+	if got.Entropy != entropy {
+		t.Errorf("Entropy = %x, want %x", got.Entropy, entropy)
+	}
+	if !got.Birthday.Equal(birthday) {
+		t.Errorf("Birthday = %v, want %v", got.Birthday, birthday)
+	}
+}
+
+func TestNewRejectsEmptyPassphrase(t *testing.T) {
+	if _, err := New([entropySize]byte{}, nil, time.Now()); err != ErrInvalidPassphrase {
+		t.Errorf("New with empty passphrase = %v, want %v", err, ErrInvalidPassphrase)
+	}
+}
+
+func TestNewRejectsBirthdayBeforeGenesis(t *testing.T) {
+	passphrase := []byte("a passphrase")
+
+	// The zero value of time.Time is an easy caller mistake and must not
+	// silently wrap into a valid-looking (but wrong) birthday.
+	if _, err := New([entropySize]byte{}, passphrase, time.Time{}); err != ErrInvalidBirthday {
+		t.Errorf("New with zero-value birthday = %v, want %v", err, ErrInvalidBirthday)
+	}
+
+	beforeGenesis := bitcoinGenesisEpoch.Add(-24 * time.Hour)
+	if _, err := New([entropySize]byte{}, passphrase, beforeGenesis); err != ErrInvalidBirthday {
+		t.Errorf("New with birthday before genesis = %v, want %v", err, ErrInvalidBirthday)
+	}
+}
+
+func TestNewRejectsBirthdayTooFarInFuture(t *testing.T) {
+	passphrase := []byte("a passphrase")
+
+	tooFar := bitcoinGenesisEpoch.Add((maxBirthdayDays + 1) * 24 * time.Hour)
+	if _, err := New([entropySize]byte{}, passphrase, tooFar); err != ErrInvalidBirthday {
+		t.Errorf("New with overflowing birthday = %v, want %v", err, ErrInvalidBirthday)
+	}
+
+	maxBirthday := bitcoinGenesisEpoch.Add(maxBirthdayDays * 24 * time.Hour)
+	if _, err := New([entropySize]byte{}, passphrase, maxBirthday); err != nil {
+		t.Errorf("New with max valid birthday returned error: %v", err)
+	}
+}
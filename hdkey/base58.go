@@ -0,0 +1,51 @@
+package hdkey
+
+import (
+	"crypto/sha256"
+	"math/big"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58CheckEncode encodes data as Base58Check: data followed by the first 4
+// bytes of its double-SHA256 hash, all base58-encoded with leading zero bytes
+// preserved as leading '1's.
+func base58CheckEncode(data []byte) string {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+
+	payload := make([]byte, 0, len(data)+4)
+	payload = append(payload, data...)
+	payload = append(payload, second[:4]...)
+
+	return base58Encode(payload)
+}
+
+func base58Encode(data []byte) string {
+	zero := big.NewInt(0)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+
+	num := new(big.Int).SetBytes(data)
+
+	var out []byte
+	for num.Cmp(zero) > 0 {
+		num.DivMod(num, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// Preserve leading zero bytes as leading '1's.
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+
+	// The digits were produced least-significant-first; reverse them.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
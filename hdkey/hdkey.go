@@ -0,0 +1,225 @@
+package hdkey
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ripemd160"
+)
+
+// Version bytes for mainnet extended keys, as defined by BIP-32/SLIP-132.
+const (
+	PrivateWalletVersion uint32 = 0x0488ADE4
+	PublicWalletVersion  uint32 = 0x0488B21E
+)
+
+// hardenedOffset is added to a path component's index to mark it as hardened.
+const hardenedOffset = 1 << 31
+
+var (
+	ErrInvalidSeedLen  = errors.New("hdkey: seed must be between 16 and 64 bytes long")
+	ErrInvalidPath     = errors.New("hdkey: invalid derivation path")
+	ErrInvalidChildKey = errors.New("hdkey: derived key is invalid, try the next index")
+	ErrNoPrivateKey    = errors.New("hdkey: key has no private part, cannot derive further children")
+)
+
+// MasterKey is a single node of a BIP-32 hierarchical-deterministic key tree.
+// It is called "MasterKey" because it is most commonly created via NewMasterKey,
+// but the same type also represents every key derived from it.
+type MasterKey struct {
+	PrivateKey        []byte // 32 bytes, nil if this is a public-only (neutered) key
+	PublicKey         []byte // 33 bytes, SEC1-compressed
+	ChainCode         []byte // 32 bytes
+	Depth             byte
+	ParentFingerprint []byte // 4 bytes
+	ChildNumber       uint32
+}
+
+// NewMasterKey derives the BIP-32 master key from seed, which is expected to be
+// the output of (*bip39.Mnemonic).GenerateSeed.
+func NewMasterKey(seed []byte) (*MasterKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeedLen
+	}
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	il, ir := sum[:32], sum[32:]
+
+	privKey := new(big.Int).SetBytes(il)
+	if privKey.Sign() == 0 || privKey.Cmp(curveN) >= 0 {
+		return nil, ErrInvalidChildKey
+	}
+
+	return &MasterKey{
+		PrivateKey:        il,
+		PublicKey:         compressedPubKey(privKey),
+		ChainCode:         ir,
+		Depth:             0,
+		ParentFingerprint: []byte{0, 0, 0, 0},
+		ChildNumber:       0,
+	}, nil
+}
+
+// Derive walks path (e.g. "m/44'/0'/0'/0/0") from k and returns the resulting key.
+// Hardened components are written with a trailing "'" or "h" (e.g. "44'" or "44h").
+func (k *MasterKey) Derive(path string) (*MasterKey, error) {
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 || (segments[0] != "m" && segments[0] != "M") {
+		return nil, ErrInvalidPath
+	}
+
+	key := k
+	for _, segment := range segments[1:] {
+		index, err := parsePathSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err = key.deriveChild(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func parsePathSegment(segment string) (uint32, error) {
+	hardened := false
+	if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H") {
+		hardened = true
+		segment = segment[:len(segment)-1]
+	}
+
+	index, err := strconv.ParseUint(segment, 10, 32)
+	if err != nil || index >= hardenedOffset {
+		return 0, ErrInvalidPath
+	}
+
+	if hardened {
+		index += hardenedOffset
+	}
+	return uint32(index), nil
+}
+
+// deriveChild implements CKDpriv from BIP-32 for a single index. Only private
+// derivation is supported: k must carry a private key, even for non-hardened
+// indices (public-only CKDpub derivation is not implemented).
+func (k *MasterKey) deriveChild(index uint32) (*MasterKey, error) {
+	if k.PrivateKey == nil {
+		return nil, ErrNoPrivateKey
+	}
+
+	hardened := index >= hardenedOffset
+
+	var data []byte
+	if hardened {
+		data = make([]byte, 0, 37)
+		data = append(data, 0x00)
+		data = append(data, leftPad32(k.PrivateKey)...)
+	} else {
+		data = make([]byte, 0, 37)
+		data = append(data, k.PublicKey...)
+	}
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index)
+	data = append(data, indexBytes...)
+
+	mac := hmac.New(sha512.New, k.ChainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	il, ir := sum[:32], sum[32:]
+	ilNum := new(big.Int).SetBytes(il)
+	if ilNum.Cmp(curveN) >= 0 {
+		return nil, ErrInvalidChildKey
+	}
+
+	child := &MasterKey{
+		ChainCode:         ir,
+		Depth:             k.Depth + 1,
+		ParentFingerprint: fingerprint(k.PublicKey),
+		ChildNumber:       index,
+	}
+
+	parentKey := new(big.Int).SetBytes(k.PrivateKey)
+	childKey := new(big.Int).Add(ilNum, parentKey)
+	childKey.Mod(childKey, curveN)
+	if childKey.Sign() == 0 {
+		return nil, ErrInvalidChildKey
+	}
+
+	child.PrivateKey = leftPad32(childKey.Bytes())
+	child.PublicKey = compressedPubKey(childKey)
+
+	return child, nil
+}
+
+// Neuter strips the private key from k, returning a public-only copy suitable
+// for producing an xpub. The extended key k itself is left untouched.
+func (k *MasterKey) Neuter() *MasterKey {
+	return &MasterKey{
+		PublicKey:         k.PublicKey,
+		ChainCode:         k.ChainCode,
+		Depth:             k.Depth,
+		ParentFingerprint: k.ParentFingerprint,
+		ChildNumber:       k.ChildNumber,
+	}
+}
+
+// String returns the Base58Check-serialized extended key: an xprv if k still
+// carries a private key, an xpub otherwise.
+func (k *MasterKey) String() string {
+	version := PublicWalletVersion
+	keyData := k.PublicKey
+	if k.PrivateKey != nil {
+		version = PrivateWalletVersion
+		keyData = append([]byte{0x00}, leftPad32(k.PrivateKey)...)
+	}
+
+	buf := make([]byte, 0, 78)
+	buf = appendUint32(buf, version)
+	buf = append(buf, k.Depth)
+	buf = append(buf, k.ParentFingerprint...)
+	buf = appendUint32(buf, k.ChildNumber)
+	buf = append(buf, k.ChainCode...)
+	buf = append(buf, keyData...)
+
+	return base58CheckEncode(buf)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return append(buf, b...)
+}
+
+func leftPad32(b []byte) []byte {
+	if len(b) >= 32 {
+		return b[len(b)-32:]
+	}
+	out := make([]byte, 32)
+	copy(out[32-len(b):], b)
+	return out
+}
+
+// fingerprint returns the first 4 bytes of HASH160(pubKey), as used for a child
+// key's parent fingerprint.
+func fingerprint(pubKey []byte) []byte {
+	sha := sha256.Sum256(pubKey)
+	ripemd := ripemd160.New()
+	if _, err := ripemd.Write(sha[:]); err != nil {
+		panic(fmt.Sprintf("hdkey: ripemd160 write failed: %v", err))
+	}
+	return ripemd.Sum(nil)[:4]
+}
@@ -0,0 +1,118 @@
+package hdkey
+
+import (
+	"math/big"
+)
+
+// Minimal secp256k1 curve arithmetic, just enough to turn a BIP-32 private key
+// into its compressed public key. The standard library does not ship this curve.
+
+var (
+	curveP  = mustBigFromHex("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F")
+	curveN  = mustBigFromHex("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141")
+	curveGx = mustBigFromHex("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	curveGy = mustBigFromHex("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8")
+)
+
+func mustBigFromHex(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("hdkey: invalid curve constant " + s)
+	}
+	return n
+}
+
+// point is an affine point on the secp256k1 curve. A nil X,Y pair represents
+// the point at infinity.
+type point struct {
+	X, Y *big.Int
+}
+
+func (p point) isInfinity() bool {
+	return p.X == nil || p.Y == nil
+}
+
+func pointDouble(p point) point {
+	if p.isInfinity() || p.Y.Sign() == 0 {
+		return point{}
+	}
+
+	// lambda = (3*x^2) / (2*y) mod P  (a = 0 for secp256k1)
+	xx := new(big.Int).Mul(p.X, p.X)
+	xx.Mul(xx, big.NewInt(3))
+	twoY := new(big.Int).Lsh(p.Y, 1)
+	twoY.ModInverse(twoY, curveP)
+	lambda := new(big.Int).Mul(xx, twoY)
+	lambda.Mod(lambda, curveP)
+
+	return addWithLambda(p, p, lambda)
+}
+
+func pointAdd(p1, p2 point) point {
+	if p1.isInfinity() {
+		return p2
+	}
+	if p2.isInfinity() {
+		return p1
+	}
+	if p1.X.Cmp(p2.X) == 0 {
+		if p1.Y.Cmp(p2.Y) != 0 {
+			return point{}
+		}
+		return pointDouble(p1)
+	}
+
+	// lambda = (y2 - y1) / (x2 - x1) mod P
+	dy := new(big.Int).Sub(p2.Y, p1.Y)
+	dx := new(big.Int).Sub(p2.X, p1.X)
+	dx.Mod(dx, curveP)
+	dx.ModInverse(dx, curveP)
+	lambda := new(big.Int).Mul(dy, dx)
+	lambda.Mod(lambda, curveP)
+
+	return addWithLambda(p1, p2, lambda)
+}
+
+func addWithLambda(p1, p2 point, lambda *big.Int) point {
+	x3 := new(big.Int).Mul(lambda, lambda)
+	x3.Sub(x3, p1.X)
+	x3.Sub(x3, p2.X)
+	x3.Mod(x3, curveP)
+
+	y3 := new(big.Int).Sub(p1.X, x3)
+	y3.Mul(y3, lambda)
+	y3.Sub(y3, p1.Y)
+	y3.Mod(y3, curveP)
+
+	return point{X: x3, Y: y3}
+}
+
+// scalarBaseMult computes k*G using double-and-add.
+func scalarBaseMult(k *big.Int) point {
+	result := point{}
+	addend := point{X: new(big.Int).Set(curveGx), Y: new(big.Int).Set(curveGy)}
+
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			result = pointAdd(result, addend)
+		}
+		addend = pointDouble(addend)
+	}
+
+	return result
+}
+
+// compressedPubKey returns the 33-byte SEC1-compressed public key for privKey.
+func compressedPubKey(privKey *big.Int) []byte {
+	p := scalarBaseMult(privKey)
+
+	out := make([]byte, 33)
+	if p.Y.Bit(0) == 0 {
+		out[0] = 0x02
+	} else {
+		out[0] = 0x03
+	}
+	p.X.FillBytes(out[1:])
+
+	return out
+}